@@ -0,0 +1,154 @@
+// Package bench provides a shared timing harness for the real_comparison
+// benchmarks so results from the matrix, quicksort, and fibonacci programs
+// (and any future ones) are collected and reported the same way.
+package bench
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"time"
+)
+
+// ErrInvalidIters is returned by TimeFunction when iters is not positive.
+var ErrInvalidIters = fmt.Errorf("bench: iters must be > 0")
+
+// ErrInvalidWarmup is returned by TimeFunction when warmup is negative.
+var ErrInvalidWarmup = fmt.Errorf("bench: warmup must be >= 0")
+
+// TimingResult captures per-iteration timings for a single named benchmark
+// run, along with the summary statistics derived from them.
+type TimingResult struct {
+	Name      string
+	Iters     int
+	Warmup    int
+	Durations []time.Duration
+
+	StartTime     time.Time
+	EndTime       time.Time
+	TotalDuration time.Duration
+
+	Min    time.Duration
+	Max    time.Duration
+	Mean   time.Duration
+	Median time.Duration
+	StdDev time.Duration
+}
+
+// Option configures a TimeFunction run.
+type Option func(*config)
+
+type config struct {
+	warmup int
+}
+
+// WithWarmup sets the number of warmup iterations to run before timing
+// begins. Warmup iterations are excluded from the reported statistics.
+func WithWarmup(n int) Option {
+	return func(c *config) { c.warmup = n }
+}
+
+// TimeFunction runs fn for iters iterations, recording the wall-clock
+// duration of each, and returns the resulting TimingResult. Any warmup
+// iterations requested via WithWarmup run first and are not timed.
+// TimeFunction returns ErrInvalidIters if iters <= 0, or ErrInvalidWarmup
+// if a negative warmup was configured via WithWarmup.
+func TimeFunction(name string, iters int, fn func(), opts ...Option) (TimingResult, error) {
+	cfg := config{}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+
+	if iters <= 0 {
+		return TimingResult{}, ErrInvalidIters
+	}
+	if cfg.warmup < 0 {
+		return TimingResult{}, ErrInvalidWarmup
+	}
+
+	for i := 0; i < cfg.warmup; i++ {
+		fn()
+	}
+
+	durations := make([]time.Duration, iters)
+	start := time.Now()
+	for i := 0; i < iters; i++ {
+		iterStart := time.Now()
+		fn()
+		durations[i] = time.Since(iterStart)
+	}
+	end := time.Now()
+
+	result := TimingResult{
+		Name:          name,
+		Iters:         iters,
+		Warmup:        cfg.warmup,
+		Durations:     durations,
+		StartTime:     start,
+		EndTime:       end,
+		TotalDuration: end.Sub(start),
+	}
+	result.computeStats()
+	return result, nil
+}
+
+// Must panics if TimeFunction returned an error, otherwise returns its
+// TimingResult. It's for call sites that pass a fixed, known-valid iters
+// (e.g. a literal), where an error can only mean a programmer mistake.
+func Must(r TimingResult, err error) TimingResult {
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+func (r *TimingResult) computeStats() {
+	if len(r.Durations) == 0 {
+		return
+	}
+
+	sorted := make([]time.Duration, len(r.Durations))
+	copy(sorted, r.Durations)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+
+	r.Min = sorted[0]
+	r.Max = sorted[len(sorted)-1]
+
+	var sum time.Duration
+	for _, d := range sorted {
+		sum += d
+	}
+	r.Mean = sum / time.Duration(len(sorted))
+
+	mid := len(sorted) / 2
+	if len(sorted)%2 == 0 {
+		r.Median = (sorted[mid-1] + sorted[mid]) / 2
+	} else {
+		r.Median = sorted[mid]
+	}
+
+	var variance float64
+	meanF := float64(r.Mean)
+	for _, d := range sorted {
+		diff := float64(d) - meanF
+		variance += diff * diff
+	}
+	variance /= float64(len(sorted))
+	r.StdDev = time.Duration(math.Sqrt(variance))
+}
+
+// Report renders a formatted multi-line summary of the result, suitable
+// for printing to stdout.
+func (r TimingResult) Report() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "%s:\n", r.Name)
+	fmt.Fprintf(&b, "  iterations: %d (warmup %d)\n", r.Iters, r.Warmup)
+	fmt.Fprintf(&b, "  total:      %s\n", r.TotalDuration)
+	fmt.Fprintf(&b, "  min:        %s\n", r.Min)
+	fmt.Fprintf(&b, "  max:        %s\n", r.Max)
+	fmt.Fprintf(&b, "  mean:       %s\n", r.Mean)
+	fmt.Fprintf(&b, "  median:     %s\n", r.Median)
+	fmt.Fprintf(&b, "  stddev:     %s\n", r.StdDev)
+	return b.String()
+}
@@ -0,0 +1,91 @@
+// cmd/compare loads two benchmark result files (newline-delimited JSON, as
+// produced by -format=json) and prints a delta table showing the percentage
+// change in mean timing for each benchmark present in both, flagging
+// statistically significant regressions via Welch's t-test.
+package main
+
+import (
+	"bufio"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"sort"
+
+	"github.com/gwon-omega/jagannath/benchmarks/real_comparison/results"
+)
+
+func loadRecords(path string) (map[string]results.Record, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	records := make(map[string]results.Record)
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 0, 64*1024), 16*1024*1024)
+	for scanner.Scan() {
+		line := scanner.Bytes()
+		if len(line) == 0 {
+			continue
+		}
+		var rec results.Record
+		if err := json.Unmarshal(line, &rec); err != nil {
+			return nil, fmt.Errorf("%s: %w", path, err)
+		}
+		records[rec.Timing.Name] = rec
+	}
+	return records, scanner.Err()
+}
+
+func main() {
+	alpha := flag.Float64("alpha", 0.05, "significance threshold for flagging a regression")
+	flag.Parse()
+	args := flag.Args()
+	if len(args) != 2 {
+		log.Fatalf("usage: compare <baseline.json> <candidate.json>")
+	}
+
+	baseline, err := loadRecords(args[0])
+	if err != nil {
+		log.Fatal(err)
+	}
+	candidate, err := loadRecords(args[1])
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	names := make([]string, 0, len(baseline))
+	for name := range baseline {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	fmt.Printf("%-40s %12s %12s %10s %10s %s\n", "benchmark", "baseline", "candidate", "delta", "p-value", "flag")
+	for _, name := range names {
+		base := baseline[name]
+		cand, ok := candidate[name]
+		if !ok {
+			continue
+		}
+
+		var pctDelta float64
+		if base.Timing.Mean != 0 {
+			pctDelta = 100 * (float64(cand.Timing.Mean-base.Timing.Mean) / float64(base.Timing.Mean))
+		}
+		p := welchTTest(base.Timing.Durations, cand.Timing.Durations)
+
+		flagStr := ""
+		switch {
+		case p < *alpha && pctDelta > 0:
+			flagStr = "REGRESSION"
+		case p < *alpha && pctDelta < 0:
+			flagStr = "improvement"
+		}
+
+		fmt.Printf("%-40s %12s %12s %+9.2f%% %10.4f %s\n",
+			name, base.Timing.Mean, cand.Timing.Mean, pctDelta, p, flagStr)
+	}
+}
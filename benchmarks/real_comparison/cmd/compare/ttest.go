@@ -0,0 +1,141 @@
+package main
+
+import (
+	"math"
+	"time"
+)
+
+// welchTTest returns the two-tailed p-value of Welch's t-test for the null
+// hypothesis that a and b have the same mean, given they may have unequal
+// variance and sample size. A small p-value means the observed difference
+// in means is unlikely to be noise.
+func welchTTest(a, b []time.Duration) float64 {
+	if len(a) < 2 || len(b) < 2 {
+		return 1 // not enough samples to say anything
+	}
+
+	meanA, varA := meanVariance(a)
+	meanB, varB := meanVariance(b)
+	nA, nB := float64(len(a)), float64(len(b))
+
+	se := math.Sqrt(varA/nA + varB/nB)
+	if se == 0 {
+		if meanA == meanB {
+			return 1
+		}
+		return 0
+	}
+	t := (meanA - meanB) / se
+
+	// Welch-Satterthwaite degrees of freedom.
+	df := math.Pow(varA/nA+varB/nB, 2) /
+		(math.Pow(varA/nA, 2)/(nA-1) + math.Pow(varB/nB, 2)/(nB-1))
+
+	return studentTTwoTailed(t, df)
+}
+
+func meanVariance(durations []time.Duration) (mean, variance float64) {
+	n := float64(len(durations))
+	var sum float64
+	for _, d := range durations {
+		sum += float64(d)
+	}
+	mean = sum / n
+
+	var sq float64
+	for _, d := range durations {
+		diff := float64(d) - mean
+		sq += diff * diff
+	}
+	variance = sq / (n - 1)
+	return mean, variance
+}
+
+// studentTTwoTailed returns P(|T| > |t|) for a Student's t distribution
+// with df degrees of freedom, via the regularized incomplete beta function.
+func studentTTwoTailed(t, df float64) float64 {
+	x := df / (df + t*t)
+	return regularizedIncompleteBeta(x, df/2, 0.5)
+}
+
+// regularizedIncompleteBeta computes I_x(a, b) using the continued-fraction
+// expansion (Numerical Recipes' betacf), which converges quickly for
+// x < (a+1)/(a+b+2); larger x is handled through the symmetry relation
+// I_x(a, b) = 1 - I_{1-x}(b, a) below.
+func regularizedIncompleteBeta(x, a, b float64) float64 {
+	if x <= 0 {
+		return 0
+	}
+	if x >= 1 {
+		return 1
+	}
+
+	lnBeta := lgamma(a+b) - lgamma(a) - lgamma(b)
+	front := math.Exp(lnBeta + a*math.Log(x) + b*math.Log(1-x))
+
+	if x < (a+1)/(a+b+2) {
+		return front * betacf(x, a, b) / a
+	}
+	return 1 - front*betacf(1-x, b, a)/b
+}
+
+func lgamma(x float64) float64 {
+	v, _ := math.Lgamma(x)
+	return v
+}
+
+// betacf evaluates the continued fraction for the incomplete beta function
+// (Numerical Recipes in C, 2nd ed., section 6.4).
+func betacf(x, a, b float64) float64 {
+	const (
+		maxIter = 200
+		eps     = 3e-14
+		tiny    = 1e-300
+	)
+
+	qab := a + b
+	qap := a + 1
+	qam := a - 1
+	c := 1.0
+	d := 1 - qab*x/qap
+	if math.Abs(d) < tiny {
+		d = tiny
+	}
+	d = 1 / d
+	h := d
+
+	for m := 1; m <= maxIter; m++ {
+		mf := float64(m)
+		m2 := 2 * mf
+
+		aa := mf * (b - mf) * x / ((qam + m2) * (a + m2))
+		d = 1 + aa*d
+		if math.Abs(d) < tiny {
+			d = tiny
+		}
+		c = 1 + aa/c
+		if math.Abs(c) < tiny {
+			c = tiny
+		}
+		d = 1 / d
+		h *= d * c
+
+		aa = -(a + mf) * (qab + mf) * x / ((a + m2) * (qap + m2))
+		d = 1 + aa*d
+		if math.Abs(d) < tiny {
+			d = tiny
+		}
+		c = 1 + aa/c
+		if math.Abs(c) < tiny {
+			c = tiny
+		}
+		d = 1 / d
+		del := d * c
+		h *= del
+
+		if math.Abs(del-1) < eps {
+			break
+		}
+	}
+	return h
+}
@@ -0,0 +1,75 @@
+package main
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+func durations(ms ...int) []time.Duration {
+	d := make([]time.Duration, len(ms))
+	for i, m := range ms {
+		d[i] = time.Duration(m) * time.Millisecond
+	}
+	return d
+}
+
+func TestWelchTTestIdenticalSamples(t *testing.T) {
+	a := durations(10, 11, 9, 10, 10)
+	b := durations(10, 11, 9, 10, 10)
+
+	p := welchTTest(a, b)
+	if p != 1 {
+		t.Fatalf("identical samples: p = %v, want 1", p)
+	}
+}
+
+func TestWelchTTestClearDifference(t *testing.T) {
+	a := durations(10, 11, 9, 10, 10)
+	b := durations(100, 110, 90, 100, 100)
+
+	p := welchTTest(a, b)
+	if p >= 0.01 {
+		t.Fatalf("clearly different samples: p = %v, want < 0.01", p)
+	}
+}
+
+func TestWelchTTestTooFewSamples(t *testing.T) {
+	a := durations(10)
+	b := durations(10, 20)
+
+	if p := welchTTest(a, b); p != 1 {
+		t.Fatalf("< 2 samples: p = %v, want 1", p)
+	}
+}
+
+func TestRegularizedIncompleteBetaBoundaries(t *testing.T) {
+	if got := regularizedIncompleteBeta(0, 2, 3); got != 0 {
+		t.Fatalf("I_0(2,3) = %v, want 0", got)
+	}
+	if got := regularizedIncompleteBeta(1, 2, 3); got != 1 {
+		t.Fatalf("I_1(2,3) = %v, want 1", got)
+	}
+}
+
+func TestRegularizedIncompleteBetaSymmetric(t *testing.T) {
+	// I_0.5(a, a) == 0.5 for any a, by symmetry of the beta distribution.
+	got := regularizedIncompleteBeta(0.5, 3, 3)
+	want := 0.5
+	if math.Abs(got-want) > 1e-9 {
+		t.Fatalf("I_0.5(3,3) = %v, want %v", got, want)
+	}
+}
+
+func TestStudentTTwoTailedKnownValue(t *testing.T) {
+	// A t-statistic of 0 should always be perfectly insignificant.
+	if got := studentTTwoTailed(0, 10); math.Abs(got-1) > 1e-9 {
+		t.Fatalf("studentTTwoTailed(0, 10) = %v, want 1", got)
+	}
+
+	// For large df the t distribution approaches the standard normal, where
+	// P(|T| > 1.96) ~= 0.05.
+	if got := studentTTwoTailed(1.96, 1e6); math.Abs(got-0.05) > 1e-3 {
+		t.Fatalf("studentTTwoTailed(1.96, 1e6) = %v, want ~0.05", got)
+	}
+}
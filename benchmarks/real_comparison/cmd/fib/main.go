@@ -0,0 +1,82 @@
+// Fibonacci benchmark - Go
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+
+	"github.com/gwon-omega/jagannath/benchmarks/real_comparison/bench"
+	"github.com/gwon-omega/jagannath/benchmarks/real_comparison/fib"
+	"github.com/gwon-omega/jagannath/benchmarks/real_comparison/results"
+)
+
+var algos = []string{"recursive", "iterative", "memoized", "matrix", "big"}
+
+func main() {
+	algo := flag.String("algo", "recursive", "fib algorithm: one of "+fmt.Sprint(algos)+" (all but big return int64 and overflow silently past n=92; use big for larger n)")
+	n := flag.Int("n", 40, "n to compute fib(n) for")
+	format := flag.String("format", "human", "output format: one of "+fmt.Sprint(results.Formats))
+	out := flag.String("out", "", "output file (default stdout)")
+	flag.Parse()
+
+	if *n < 0 {
+		log.Fatalf("-n must be >= 0, got %d", *n)
+	}
+
+	var resultStr string
+	var timing bench.TimingResult
+	name := fmt.Sprintf("fib.%s(%d)", *algo, *n)
+
+	switch *algo {
+	case "recursive":
+		var r int64
+		timing = bench.Must(bench.TimeFunction(name, 5, func() { r = fib.Recursive(*n) }, bench.WithWarmup(1)))
+		resultStr = fmt.Sprint(r)
+	case "iterative":
+		var r int64
+		timing = bench.Must(bench.TimeFunction(name, 5, func() { r = fib.Iterative(*n) }, bench.WithWarmup(1)))
+		resultStr = fmt.Sprint(r)
+	case "memoized":
+		var r int64
+		timing = bench.Must(bench.TimeFunction(name, 5, func() { r = fib.Memoized(*n) }, bench.WithWarmup(1)))
+		resultStr = fmt.Sprint(r)
+	case "matrix":
+		var r int64
+		timing = bench.Must(bench.TimeFunction(name, 5, func() { r = fib.Matrix(*n) }, bench.WithWarmup(1)))
+		resultStr = fmt.Sprint(r)
+	case "big":
+		var r string
+		timing = bench.Must(bench.TimeFunction(name, 5, func() { r = fib.Big(*n).String() }, bench.WithWarmup(1)))
+		resultStr = truncate(r, 40)
+	default:
+		log.Fatalf("unknown algo %q, want one of %v", *algo, algos)
+	}
+
+	w, err := results.Open(*out)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer w.Close()
+
+	writer, err := results.NewWriter(*format, w)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if *format == "human" {
+		fmt.Printf("Go: fib(%d) [%s] = %s\n", *n, *algo, resultStr)
+	}
+	if err := writer.Write(results.Record{Meta: results.CollectMeta(), Timing: timing}); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// truncate shortens long decimal strings (e.g. fib(100000) has ~20900
+// digits) to a head...tail preview so the result line stays readable.
+func truncate(s string, max int) string {
+	if len(s) <= max {
+		return s
+	}
+	return fmt.Sprintf("%s...%s (%d digits)", s[:max/2], s[len(s)-max/2:], len(s))
+}
@@ -0,0 +1,194 @@
+// Matrix multiplication benchmark - Go
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"strings"
+	"sync"
+
+	"github.com/gwon-omega/jagannath/benchmarks/real_comparison/bench"
+	"github.com/gwon-omega/jagannath/benchmarks/real_comparison/results"
+)
+
+const N = 512
+
+var A [N][N]float64
+var B [N][N]float64
+var C [N][N]float64
+
+func matrixMult() {
+	for i := 0; i < N; i++ {
+		for j := 0; j < N; j++ {
+			sum := 0.0
+			for k := 0; k < N; k++ {
+				sum += A[i][k] * B[k][j]
+			}
+			C[i][j] = sum
+		}
+	}
+}
+
+// matrixMultTransposed pre-transposes B so the inner loop walks BT
+// contiguously instead of striding down B's columns.
+func matrixMultTransposed() {
+	var BT [N][N]float64
+	for i := 0; i < N; i++ {
+		for j := 0; j < N; j++ {
+			BT[j][i] = B[i][j]
+		}
+	}
+
+	for i := 0; i < N; i++ {
+		for j := 0; j < N; j++ {
+			sum := 0.0
+			for k := 0; k < N; k++ {
+				sum += A[i][k] * BT[j][k]
+			}
+			C[i][j] = sum
+		}
+	}
+}
+
+// matrixMultBlocked computes C = A*B one blockSize x blockSize tile of C at
+// a time, accumulating over corresponding tiles of A and B so each tile's
+// working set stays cache-resident.
+func matrixMultBlocked(blockSize int) {
+	for i := 0; i < N; i++ {
+		for j := 0; j < N; j++ {
+			C[i][j] = 0
+		}
+	}
+
+	for ii := 0; ii < N; ii += blockSize {
+		iMax := min(ii+blockSize, N)
+		for jj := 0; jj < N; jj += blockSize {
+			jMax := min(jj+blockSize, N)
+			for kk := 0; kk < N; kk += blockSize {
+				kMax := min(kk+blockSize, N)
+				for i := ii; i < iMax; i++ {
+					for k := kk; k < kMax; k++ {
+						aik := A[i][k]
+						for j := jj; j < jMax; j++ {
+							C[i][j] += aik * B[k][j]
+						}
+					}
+				}
+			}
+		}
+	}
+}
+
+func min(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+// matrixMultParallel computes C = A*B with the rows of C partitioned across
+// workers goroutines that each pull row indices from a shared channel.
+func matrixMultParallel(workers int) {
+	rows := make(chan int, N)
+	for i := 0; i < N; i++ {
+		rows <- i
+	}
+	close(rows)
+
+	var wg sync.WaitGroup
+	wg.Add(workers)
+	for w := 0; w < workers; w++ {
+		go func() {
+			defer wg.Done()
+			for i := range rows {
+				for j := 0; j < N; j++ {
+					sum := 0.0
+					for k := 0; k < N; k++ {
+						sum += A[i][k] * B[k][j]
+					}
+					C[i][j] = sum
+				}
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+var workerCounts = []int{1, 2, 4, 8}
+
+func main() {
+	kernels := flag.String("kernels", "ijk,transposed,blocked", "comma-separated kernels to run: ijk, transposed, blocked")
+	blockSize := flag.Int("block", 64, "tile size for the blocked kernel")
+	format := flag.String("format", "human", "output format: one of "+fmt.Sprint(results.Formats))
+	out := flag.String("out", "", "output file (default stdout)")
+	flag.Parse()
+
+	if *blockSize <= 0 {
+		log.Fatalf("-block must be > 0, got %d", *blockSize)
+	}
+
+	w, err := results.Open(*out)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer w.Close()
+
+	writer, err := results.NewWriter(*format, w)
+	if err != nil {
+		log.Fatal(err)
+	}
+	meta := results.CollectMeta()
+
+	record := func(timing bench.TimingResult) {
+		if err := writer.Write(results.Record{Meta: meta, Timing: timing}); err != nil {
+			log.Fatal(err)
+		}
+	}
+
+	for i := 0; i < N; i++ {
+		for j := 0; j < N; j++ {
+			A[i][j] = float64(i+j) / N
+			B[i][j] = float64(i-j) / N
+		}
+	}
+
+	for _, kernel := range strings.Split(*kernels, ",") {
+		switch kernel {
+		case "ijk":
+			timing := bench.Must(bench.TimeFunction("matrixMult", 3, matrixMult))
+			if *format == "human" {
+				fmt.Printf("Go: %dx%d matrix mult, C[0][0]=%.4f\n", N, N, C[0][0])
+			}
+			record(timing)
+
+			for _, workers := range workerCounts {
+				name := fmt.Sprintf("matrixMultParallel(workers=%d)", workers)
+				timing := bench.Must(bench.TimeFunction(name, 3, func() {
+					matrixMultParallel(workers)
+				}))
+				if *format == "human" {
+					fmt.Printf("Go: %dx%d matrix mult, workers=%d, C[0][0]=%.4f\n", N, N, workers, C[0][0])
+				}
+				record(timing)
+			}
+		case "transposed":
+			timing := bench.Must(bench.TimeFunction("matrixMultTransposed", 3, matrixMultTransposed))
+			if *format == "human" {
+				fmt.Printf("Go: %dx%d matrix mult (transposed), C[0][0]=%.4f\n", N, N, C[0][0])
+			}
+			record(timing)
+		case "blocked":
+			name := fmt.Sprintf("matrixMultBlocked(block=%d)", *blockSize)
+			timing := bench.Must(bench.TimeFunction(name, 3, func() {
+				matrixMultBlocked(*blockSize)
+			}))
+			if *format == "human" {
+				fmt.Printf("Go: %dx%d matrix mult, block=%d, C[0][0]=%.4f\n", N, N, *blockSize, C[0][0])
+			}
+			record(timing)
+		default:
+			fmt.Printf("unknown kernel %q, skipping\n", kernel)
+		}
+	}
+}
@@ -0,0 +1,174 @@
+// Sort-algorithm shootout benchmark - Go
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/gwon-omega/jagannath/benchmarks/real_comparison/bench"
+	"github.com/gwon-omega/jagannath/benchmarks/real_comparison/results"
+	"github.com/gwon-omega/jagannath/benchmarks/real_comparison/sorts"
+	"github.com/gwon-omega/jagannath/benchmarks/real_comparison/workload"
+)
+
+// parallelThreshold is the minimum partition size above which
+// quicksortParallel spawns a goroutine instead of recursing serially.
+const parallelThreshold = 10000
+
+// quicksortParallel sorts a[low:high+1] in place, spawning a bounded number
+// of goroutines (tracked by sem) for the sub-partitions of any partition
+// larger than parallelThreshold, and falling back to sorts.QuickSortLomuto
+// for partitions of at most that size.
+func quicksortParallel(a []int, low, high int, sem chan struct{}) {
+	if low >= high {
+		return
+	}
+	if high-low < parallelThreshold {
+		sorts.QuickSortLomuto(a[low : high+1])
+		return
+	}
+
+	pivot := a[high]
+	i := low - 1
+	for j := low; j < high; j++ {
+		if a[j] <= pivot {
+			i++
+			a[i], a[j] = a[j], a[i]
+		}
+	}
+	a[i+1], a[high] = a[high], a[i+1]
+	pi := i + 1
+
+	select {
+	case sem <- struct{}{}:
+		var wg sync.WaitGroup
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer func() { <-sem }()
+			quicksortParallel(a, low, pi-1, sem)
+		}()
+		quicksortParallel(a, pi+1, high, sem)
+		wg.Wait()
+	default:
+		quicksortParallel(a, low, pi-1, sem)
+		quicksortParallel(a, pi+1, high, sem)
+	}
+}
+
+var workerCounts = []int{1, 2, 4, 8}
+
+// lomutoQuadraticCutoff bounds the size at which QuickSortLomuto is still
+// run against reversed/nearly-sorted input. Lomuto always pivots on the
+// last element, so those distributions are its worst case: O(n^2) instead
+// of the expected O(n log n). Above this cutoff the run time becomes
+// impractical (a reversed 80k-element run already takes ~3s; 10M would
+// take hours), so those combinations are skipped with a warning instead of
+// silently hanging.
+const lomutoQuadraticCutoff = 20000
+
+func isLomutoWorstCase(sorterName, dist string) bool {
+	return sorterName == "QuickSortLomuto" && (dist == workload.Reversed || dist == workload.NearlySorted)
+}
+
+func parseSizes(s string) ([]int, error) {
+	var sizes []int
+	for _, part := range strings.Split(s, ",") {
+		n, err := strconv.Atoi(strings.TrimSpace(part))
+		if err != nil {
+			return nil, fmt.Errorf("invalid size %q: %w", part, err)
+		}
+		if n <= 0 {
+			return nil, fmt.Errorf("invalid size %q: must be > 0", part)
+		}
+		sizes = append(sizes, n)
+	}
+	return sizes, nil
+}
+
+func main() {
+	seed := flag.Uint64("seed", 42, "PRNG seed")
+	source := flag.String("source", workload.PCG, "PRNG source: one of "+fmt.Sprint(workload.Sources))
+	sizesFlag := flag.String("sizes", "10000,100000,1000000", "comma-separated input sizes")
+	distsFlag := flag.String("dists", strings.Join(workload.Distributions, ","), "comma-separated distributions: one of "+fmt.Sprint(workload.Distributions))
+	iters := flag.Int("iters", 3, "timed iterations per (sorter, size, distribution)")
+	format := flag.String("format", "human", "output format: one of "+fmt.Sprint(results.Formats))
+	out := flag.String("out", "", "output file (default stdout)")
+	flag.Parse()
+
+	if *iters <= 0 {
+		log.Fatalf("-iters must be > 0, got %d", *iters)
+	}
+
+	rng, err := workload.NewRand(*source, *seed)
+	if err != nil {
+		log.Fatal(err)
+	}
+	sizes, err := parseSizes(*sizesFlag)
+	if err != nil {
+		log.Fatal(err)
+	}
+	dists := strings.Split(*distsFlag, ",")
+
+	w, err := results.Open(*out)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer w.Close()
+
+	writer, err := results.NewWriter(*format, w)
+	if err != nil {
+		log.Fatal(err)
+	}
+	meta := results.CollectMeta()
+
+	record := func(timing bench.TimingResult) {
+		if err := writer.Write(results.Record{Meta: meta, Timing: timing}); err != nil {
+			log.Fatal(err)
+		}
+	}
+
+	for _, dist := range dists {
+		for _, size := range sizes {
+			base, err := workload.GenerateInts(rng, size, dist)
+			if err != nil {
+				log.Fatal(err)
+			}
+
+			for _, s := range sorts.Registry {
+				if isLomutoWorstCase(s.Name, dist) && size > lomutoQuadraticCutoff {
+					log.Printf("skipping %s(size=%d,dist=%s): last-element pivot is O(n^2) on this distribution above size %d", s.Name, size, dist, lomutoQuadraticCutoff)
+					continue
+				}
+
+				name := fmt.Sprintf("%s(size=%d,dist=%s)", s.Name, size, dist)
+				scratch := make([]int, size)
+				timing := bench.Must(bench.TimeFunction(name, *iters, func() {
+					copy(scratch, base)
+					s.Fn(scratch)
+				}))
+				record(timing)
+			}
+		}
+	}
+
+	size := sizes[len(sizes)-1]
+	base, err := workload.GenerateInts(rng, size, workload.Random)
+	if err != nil {
+		log.Fatal(err)
+	}
+	scratch := make([]int, size)
+	for _, workers := range workerCounts {
+		name := fmt.Sprintf("quicksortParallel(workers=%d,size=%d)", workers, size)
+		timing := bench.Must(bench.TimeFunction(name, *iters, func() {
+			copy(scratch, base)
+			sem := make(chan struct{}, workers)
+			quicksortParallel(scratch, 0, size-1, sem)
+		}))
+		record(timing)
+	}
+}
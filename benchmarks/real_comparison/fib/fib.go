@@ -0,0 +1,100 @@
+// Package fib provides several Fibonacci implementations with different
+// algorithmic complexity, so the benchmark measures more than raw
+// recursion overhead.
+package fib
+
+import "math/big"
+
+// Recursive computes fib(n) the naive way: two recursive calls per level,
+// O(phi^n) time. Like the other int64 variants, it silently overflows for
+// n > 92 (fib(93) exceeds math.MaxInt64); use Big for larger n.
+func Recursive(n int) int64 {
+	if n <= 1 {
+		return int64(n)
+	}
+	return Recursive(n-1) + Recursive(n-2)
+}
+
+// Iterative computes fib(n) by walking forward with two accumulators,
+// O(n) time and O(1) space. Valid only up to n=92; beyond that the int64
+// result overflows without error. Use Big for n=100000-scale inputs.
+func Iterative(n int) int64 {
+	if n <= 1 {
+		return int64(n)
+	}
+	var a, b int64 = 0, 1
+	for i := 2; i <= n; i++ {
+		a, b = b, a+b
+	}
+	return b
+}
+
+// Memoized computes fib(n) by recursing with a cache of already-computed
+// values, turning the naive O(phi^n) recursion into O(n). Same int64 range
+// limit as Iterative: overflows silently past n=92.
+func Memoized(n int) int64 {
+	cache := make([]int64, n+1)
+	for i := range cache {
+		cache[i] = -1
+	}
+
+	var rec func(n int) int64
+	rec = func(n int) int64 {
+		if n <= 1 {
+			return int64(n)
+		}
+		if cache[n] != -1 {
+			return cache[n]
+		}
+		result := rec(n-1) + rec(n-2)
+		cache[n] = result
+		return result
+	}
+	return rec(n)
+}
+
+// Matrix computes fib(n) via 2x2 matrix exponentiation by squaring,
+// O(log n) time. Also int64-valued, so it overflows silently past n=92.
+func Matrix(n int) int64 {
+	if n <= 1 {
+		return int64(n)
+	}
+	// [[1,1],[1,0]]^n == [[fib(n+1),fib(n)],[fib(n),fib(n-1)]]
+	result := matPow([4]int64{1, 1, 1, 0}, n)
+	return result[1]
+}
+
+// matPow raises the 2x2 matrix m (stored row-major as [a,b,c,d]) to the
+// e-th power using exponentiation by squaring.
+func matPow(m [4]int64, e int) [4]int64 {
+	result := [4]int64{1, 0, 0, 1} // identity
+	for e > 0 {
+		if e&1 == 1 {
+			result = matMul(result, m)
+		}
+		m = matMul(m, m)
+		e >>= 1
+	}
+	return result
+}
+
+func matMul(x, y [4]int64) [4]int64 {
+	return [4]int64{
+		x[0]*y[0] + x[1]*y[2], x[0]*y[1] + x[1]*y[3],
+		x[2]*y[0] + x[3]*y[2], x[2]*y[1] + x[3]*y[3],
+	}
+}
+
+// Big computes fib(n) iteratively using math/big, so n can be large enough
+// (e.g. 1000 or 100000) that the result overflows int64.
+func Big(n int) *big.Int {
+	if n <= 1 {
+		return big.NewInt(int64(n))
+	}
+	a, b := big.NewInt(0), big.NewInt(1)
+	for i := 2; i <= n; i++ {
+		a.Add(a, b)
+		a, b = b, a
+	}
+	return b
+}
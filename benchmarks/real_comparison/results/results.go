@@ -0,0 +1,57 @@
+// Package results serializes bench.TimingResult values to a file or
+// stream, alongside the run metadata needed to compare results across
+// machines, Go versions, and commits.
+package results
+
+import (
+	"os"
+	"os/exec"
+	"runtime"
+	"strings"
+	"time"
+
+	"github.com/gwon-omega/jagannath/benchmarks/real_comparison/bench"
+)
+
+// Meta describes the environment a benchmark ran in.
+type Meta struct {
+	Hostname   string    `json:"hostname"`
+	GoVersion  string    `json:"go_version"`
+	GOOS       string    `json:"goos"`
+	GOARCH     string    `json:"goarch"`
+	GOMAXPROCS int       `json:"gomaxprocs"`
+	GitSHA     string    `json:"git_sha"`
+	Timestamp  time.Time `json:"timestamp"`
+}
+
+// CollectMeta gathers the current environment's Meta. GitSHA is left blank
+// if git isn't available or the working directory isn't a repo.
+func CollectMeta() Meta {
+	hostname, _ := os.Hostname()
+	return Meta{
+		Hostname:   hostname,
+		GoVersion:  runtime.Version(),
+		GOOS:       runtime.GOOS,
+		GOARCH:     runtime.GOARCH,
+		GOMAXPROCS: runtime.GOMAXPROCS(0),
+		GitSHA:     gitSHA(),
+		Timestamp:  time.Now(),
+	}
+}
+
+func gitSHA() string {
+	out, err := exec.Command("git", "rev-parse", "HEAD").Output()
+	if err != nil {
+		return ""
+	}
+	return strings.TrimSpace(string(out))
+}
+
+// Record pairs a TimingResult with the Meta describing where it ran.
+type Record struct {
+	Meta   Meta               `json:"meta"`
+	Timing bench.TimingResult `json:"timing"`
+}
+
+// Formats lists the valid -format flag values.
+var Formats = []string{"human", "json", "csv"}
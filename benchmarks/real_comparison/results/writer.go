@@ -0,0 +1,104 @@
+package results
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+)
+
+// Open returns a writer for the -out flag's value: os.Stdout if path is
+// empty, otherwise a newly created file at path. The caller must Close the
+// result.
+func Open(path string) (io.WriteCloser, error) {
+	if path == "" {
+		return nopCloser{os.Stdout}, nil
+	}
+	return os.Create(path)
+}
+
+type nopCloser struct{ io.Writer }
+
+func (nopCloser) Close() error { return nil }
+
+// Writer records benchmark results one at a time, in whatever format it was
+// constructed for.
+type Writer interface {
+	Write(Record) error
+}
+
+// NewWriter returns a Writer for the named format ("human", "json", or
+// "csv"), writing to w.
+func NewWriter(format string, w io.Writer) (Writer, error) {
+	switch format {
+	case "human":
+		return &humanWriter{w: w}, nil
+	case "json":
+		return &jsonWriter{enc: json.NewEncoder(w)}, nil
+	case "csv":
+		return &csvWriter{w: csv.NewWriter(w)}, nil
+	default:
+		return nil, fmt.Errorf("results: unknown format %q (want one of %v)", format, Formats)
+	}
+}
+
+type humanWriter struct {
+	w io.Writer
+}
+
+func (h *humanWriter) Write(rec Record) error {
+	_, err := fmt.Fprint(h.w, rec.Timing.Report())
+	return err
+}
+
+// jsonWriter writes one JSON object per Record (newline-delimited JSON),
+// so files can be appended to and streamed without reading the whole
+// document into memory.
+type jsonWriter struct {
+	enc *json.Encoder
+}
+
+func (j *jsonWriter) Write(rec Record) error {
+	return j.enc.Encode(rec)
+}
+
+var csvHeader = []string{
+	"name", "iters", "warmup",
+	"min_ns", "max_ns", "mean_ns", "median_ns", "stddev_ns", "total_ns",
+	"hostname", "go_version", "goos", "goarch", "gomaxprocs", "git_sha", "timestamp",
+}
+
+type csvWriter struct {
+	w             *csv.Writer
+	headerWritten bool
+}
+
+func (c *csvWriter) Write(rec Record) error {
+	if !c.headerWritten {
+		if err := c.w.Write(csvHeader); err != nil {
+			return err
+		}
+		c.headerWritten = true
+	}
+
+	t := rec.Timing
+	m := rec.Meta
+	row := []string{
+		t.Name, strconv.Itoa(t.Iters), strconv.Itoa(t.Warmup),
+		strconv.FormatInt(t.Min.Nanoseconds(), 10),
+		strconv.FormatInt(t.Max.Nanoseconds(), 10),
+		strconv.FormatInt(t.Mean.Nanoseconds(), 10),
+		strconv.FormatInt(t.Median.Nanoseconds(), 10),
+		strconv.FormatInt(t.StdDev.Nanoseconds(), 10),
+		strconv.FormatInt(t.TotalDuration.Nanoseconds(), 10),
+		m.Hostname, m.GoVersion, m.GOOS, m.GOARCH, strconv.Itoa(m.GOMAXPROCS), m.GitSHA,
+		m.Timestamp.Format("2006-01-02T15:04:05Z07:00"),
+	}
+	if err := c.w.Write(row); err != nil {
+		return err
+	}
+	c.w.Flush()
+	return c.w.Error()
+}
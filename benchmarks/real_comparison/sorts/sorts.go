@@ -0,0 +1,262 @@
+// Package sorts collects several sorting-algorithm implementations behind
+// a common interface so they can be benchmarked head-to-head over the same
+// inputs.
+package sorts
+
+import "sort"
+
+// Sorter sorts a in place, ascending.
+type Sorter func(a []int)
+
+// Named pairs a Sorter with the name it should be reported under.
+type Named struct {
+	Name string
+	Fn   Sorter
+}
+
+// Registry lists every sorter in this package, in the order they should be
+// run and reported.
+var Registry = []Named{
+	{"QuickSortLomuto", QuickSortLomuto},
+	{"QuickSortHoare", QuickSortHoare},
+	{"MergeSort", MergeSort},
+	{"HeapSort", HeapSort},
+	{"ShellSort", ShellSort},
+	{"CountingSort", CountingSort},
+	{"StdlibSort", StdlibSort},
+}
+
+// QuickSortLomuto sorts a using Lomuto partitioning with the last element
+// as pivot.
+func QuickSortLomuto(a []int) {
+	var quicksort func(low, high int)
+	quicksort = func(low, high int) {
+		if low >= high {
+			return
+		}
+		pivot := a[high]
+		i := low - 1
+		for j := low; j < high; j++ {
+			if a[j] <= pivot {
+				i++
+				a[i], a[j] = a[j], a[i]
+			}
+		}
+		a[i+1], a[high] = a[high], a[i+1]
+		pi := i + 1
+		quicksort(low, pi-1)
+		quicksort(pi+1, high)
+	}
+	quicksort(0, len(a)-1)
+}
+
+// hoareInsertionCutoff is the partition size at or below which
+// QuickSortHoare switches to insertion sort.
+const hoareInsertionCutoff = 16
+
+// QuickSortHoare sorts a using Hoare partitioning with a median-of-three
+// pivot, falling back to insertion sort for small partitions.
+func QuickSortHoare(a []int) {
+	var quicksort func(low, high int)
+	quicksort = func(low, high int) {
+		for high-low+1 > hoareInsertionCutoff {
+			p := hoarePartition(a, low, high)
+			if p-low < high-p {
+				quicksort(low, p)
+				low = p + 1
+			} else {
+				quicksort(p+1, high)
+				high = p
+			}
+		}
+		insertionSort(a, low, high)
+	}
+	quicksort(0, len(a)-1)
+}
+
+func hoarePartition(a []int, low, high int) int {
+	mid := low + (high-low)/2
+	medianOfThree(a, low, mid, high)
+	pivot := a[low]
+
+	i, j := low-1, high+1
+	for {
+		for {
+			i++
+			if a[i] >= pivot {
+				break
+			}
+		}
+		for {
+			j--
+			if a[j] <= pivot {
+				break
+			}
+		}
+		if i >= j {
+			return j
+		}
+		a[i], a[j] = a[j], a[i]
+	}
+}
+
+// medianOfThree reorders a[low], a[mid], a[high] so a[low] holds their
+// median, which hoarePartition then uses as the pivot.
+func medianOfThree(a []int, low, mid, high int) {
+	if a[mid] < a[low] {
+		a[mid], a[low] = a[low], a[mid]
+	}
+	if a[high] < a[low] {
+		a[high], a[low] = a[low], a[high]
+	}
+	if a[high] < a[mid] {
+		a[high], a[mid] = a[mid], a[high]
+	}
+	a[low], a[mid] = a[mid], a[low]
+}
+
+func insertionSort(a []int, low, high int) {
+	for i := low + 1; i <= high; i++ {
+		v := a[i]
+		j := i - 1
+		for j >= low && a[j] > v {
+			a[j+1] = a[j]
+			j--
+		}
+		a[j+1] = v
+	}
+}
+
+// MergeSort sorts a using a standard top-down merge sort.
+func MergeSort(a []int) {
+	if len(a) < 2 {
+		return
+	}
+	buf := make([]int, len(a))
+	var sort func(lo, hi int)
+	sort = func(lo, hi int) {
+		if hi-lo < 2 {
+			return
+		}
+		mid := lo + (hi-lo)/2
+		sort(lo, mid)
+		sort(mid, hi)
+
+		copy(buf[lo:hi], a[lo:hi])
+		i, j, k := lo, mid, lo
+		for i < mid && j < hi {
+			if buf[i] <= buf[j] {
+				a[k] = buf[i]
+				i++
+			} else {
+				a[k] = buf[j]
+				j++
+			}
+			k++
+		}
+		for i < mid {
+			a[k] = buf[i]
+			i++
+			k++
+		}
+		for j < hi {
+			a[k] = buf[j]
+			j++
+			k++
+		}
+	}
+	sort(0, len(a))
+}
+
+// HeapSort sorts a in place using a binary max-heap.
+func HeapSort(a []int) {
+	n := len(a)
+	for i := n/2 - 1; i >= 0; i-- {
+		siftDown(a, i, n)
+	}
+	for end := n - 1; end > 0; end-- {
+		a[0], a[end] = a[end], a[0]
+		siftDown(a, 0, end)
+	}
+}
+
+func siftDown(a []int, root, n int) {
+	for {
+		largest := root
+		l, r := 2*root+1, 2*root+2
+		if l < n && a[l] > a[largest] {
+			largest = l
+		}
+		if r < n && a[r] > a[largest] {
+			largest = r
+		}
+		if largest == root {
+			return
+		}
+		a[root], a[largest] = a[largest], a[root]
+		root = largest
+	}
+}
+
+// shellGaps is the gap sequence ShellSort uses (Marcin Ciura's gaps,
+// extended geometrically for larger inputs).
+var shellGaps = []int{1, 4, 10, 23, 57, 132, 301, 701}
+
+// ShellSort sorts a using Shell's diminishing-increment insertion sort.
+func ShellSort(a []int) {
+	n := len(a)
+	gaps := make([]int, len(shellGaps))
+	copy(gaps, shellGaps)
+	for g := gaps[len(gaps)-1] * 3; g < n; g = g*3 + 1 {
+		gaps = append(gaps, g)
+	}
+
+	for gi := len(gaps) - 1; gi >= 0; gi-- {
+		gap := gaps[gi]
+		for i := gap; i < n; i++ {
+			v := a[i]
+			j := i
+			for j >= gap && a[j-gap] > v {
+				a[j] = a[j-gap]
+				j -= gap
+			}
+			a[j] = v
+		}
+	}
+}
+
+// CountingSort sorts a using counting sort over the range [min(a), max(a)].
+// It assumes a bounded value range; for wide-range inputs prefer a
+// comparison sort instead.
+func CountingSort(a []int) {
+	if len(a) == 0 {
+		return
+	}
+	min, max := a[0], a[0]
+	for _, v := range a[1:] {
+		if v < min {
+			min = v
+		}
+		if v > max {
+			max = v
+		}
+	}
+
+	counts := make([]int, max-min+1)
+	for _, v := range a {
+		counts[v-min]++
+	}
+	i := 0
+	for v, c := range counts {
+		for ; c > 0; c-- {
+			a[i] = v + min
+			i++
+		}
+	}
+}
+
+// StdlibSort wraps sort.Slice for a baseline comparison against the
+// hand-written implementations above.
+func StdlibSort(a []int) {
+	sort.Slice(a, func(i, j int) bool { return a[i] < a[j] })
+}
@@ -0,0 +1,55 @@
+package sorts
+
+import (
+	"math/rand"
+	"sort"
+	"testing"
+)
+
+func TestRegistrySorts(t *testing.T) {
+	cases := [][]int{
+		nil,
+		{},
+		{1},
+		{2, 1},
+		{5, 4, 3, 2, 1},
+		{1, 2, 3, 4, 5},
+		{3, 1, 4, 1, 5, 9, 2, 6, 5, 3, 5},
+	}
+
+	r := rand.New(rand.NewSource(1))
+	shuffled := make([]int, 200)
+	for i := range shuffled {
+		shuffled[i] = r.Intn(50)
+	}
+	cases = append(cases, shuffled)
+
+	for _, entry := range Registry {
+		entry := entry
+		t.Run(entry.Name, func(t *testing.T) {
+			for _, c := range cases {
+				got := append([]int(nil), c...)
+				entry.Fn(got)
+
+				want := append([]int(nil), c...)
+				sort.Ints(want)
+
+				if !equal(got, want) {
+					t.Fatalf("%s(%v) = %v, want %v", entry.Name, c, got, want)
+				}
+			}
+		})
+	}
+}
+
+func equal(a, b []int) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
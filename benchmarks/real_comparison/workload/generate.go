@@ -0,0 +1,59 @@
+package workload
+
+import (
+	"fmt"
+	mrand "math/rand"
+)
+
+// Distribution names accepted by GenerateInts.
+const (
+	Random       = "random"
+	NearlySorted = "nearly-sorted"
+	Reversed     = "reversed"
+	FewUnique    = "few-unique"
+)
+
+// Distributions lists the valid GenerateInts distribution names, in the
+// order they should be presented in help text.
+var Distributions = []string{Random, NearlySorted, Reversed, FewUnique}
+
+// GenerateInts returns a slice of n ints drawn from r, shaped according to
+// dist:
+//
+//   - Random: uniformly distributed in [0, n).
+//   - NearlySorted: sorted ascending, then n/100 pairs of randomly chosen
+//     positions (not necessarily adjacent) are swapped.
+//   - Reversed: sorted descending.
+//   - FewUnique: uniformly distributed over a small fixed set of values.
+func GenerateInts(r *mrand.Rand, n int, dist string) ([]int, error) {
+	a := make([]int, n)
+
+	switch dist {
+	case Random:
+		for i := range a {
+			a[i] = r.Intn(n)
+		}
+	case NearlySorted:
+		for i := range a {
+			a[i] = i
+		}
+		swaps := n / 100
+		for s := 0; s < swaps; s++ {
+			i := r.Intn(n)
+			j := r.Intn(n)
+			a[i], a[j] = a[j], a[i]
+		}
+	case Reversed:
+		for i := range a {
+			a[i] = n - i
+		}
+	case FewUnique:
+		const uniqueValues = 8
+		for i := range a {
+			a[i] = r.Intn(uniqueValues)
+		}
+	default:
+		return nil, fmt.Errorf("workload: unknown distribution %q (want one of %v)", dist, Distributions)
+	}
+	return a, nil
+}
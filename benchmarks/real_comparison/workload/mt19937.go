@@ -0,0 +1,60 @@
+package workload
+
+// mt19937Source is a pure-Go implementation of MT19937-64, the 64-bit
+// Mersenne Twister, for parity with the C++/other language ports of this
+// benchmark suite that default to it.
+type mt19937Source struct {
+	state [mtNN]uint64
+	index int
+}
+
+const (
+	mtNN        = 312
+	mtMM        = 156
+	mtMatrixA   = 0xB5026F5AA96619E9
+	mtUpperMask = 0xFFFFFFFF80000000
+	mtLowerMask = 0x7FFFFFFF
+)
+
+func newMT19937Source(seed uint64) *mt19937Source {
+	s := &mt19937Source{}
+	s.Seed(int64(seed))
+	return s
+}
+
+func (s *mt19937Source) Seed(seed int64) {
+	s.state[0] = uint64(seed)
+	for i := 1; i < mtNN; i++ {
+		s.state[i] = 6364136223846793005*(s.state[i-1]^(s.state[i-1]>>62)) + uint64(i)
+	}
+	s.index = mtNN
+}
+
+func (s *mt19937Source) Uint64() uint64 {
+	if s.index >= mtNN {
+		s.twist()
+	}
+	x := s.state[s.index]
+	s.index++
+
+	x ^= (x >> 29) & 0x5555555555555555
+	x ^= (x << 17) & 0x71D67FFFEDA60000
+	x ^= (x << 37) & 0xFFF7EEE000000000
+	x ^= x >> 43
+	return x
+}
+
+func (s *mt19937Source) Int63() int64 {
+	return int64(s.Uint64() >> 1)
+}
+
+func (s *mt19937Source) twist() {
+	var mag01 = [2]uint64{0, mtMatrixA}
+	for i := 0; i < mtNN; i++ {
+		x := (s.state[i] & mtUpperMask) | (s.state[(i+1)%mtNN] & mtLowerMask)
+		xA := x >> 1
+		xA ^= mag01[x&1]
+		s.state[i] = s.state[(i+mtMM)%mtNN] ^ xA
+	}
+	s.index = 0
+}
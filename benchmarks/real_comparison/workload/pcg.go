@@ -0,0 +1,48 @@
+package workload
+
+// pcgSource is a pure-Go implementation of PCG32 (O'Neill's permuted
+// congruential generator), producing 64-bit values as two successive
+// 32-bit draws. It is the default source: fast, small, and statistically
+// solid for benchmark input generation.
+type pcgSource struct {
+	state uint64
+	inc   uint64
+}
+
+const pcgMultiplier = 6364136223846793005
+
+func newPCGSource(seed uint64) *pcgSource {
+	s := &pcgSource{}
+	s.Seed(int64(seed))
+	return s
+}
+
+func (s *pcgSource) Seed(seed int64) {
+	s.state = 0
+	s.inc = (uint64(seed) << 1) | 1
+	s.step()
+	s.state += uint64(seed)
+	s.step()
+}
+
+func (s *pcgSource) step() {
+	s.state = s.state*pcgMultiplier + s.inc
+}
+
+func (s *pcgSource) uint32() uint32 {
+	old := s.state
+	s.step()
+	xorshifted := uint32(((old >> 18) ^ old) >> 27)
+	rot := uint32(old >> 59)
+	return (xorshifted >> rot) | (xorshifted << ((-rot) & 31))
+}
+
+func (s *pcgSource) Uint64() uint64 {
+	hi := s.uint32()
+	lo := s.uint32()
+	return uint64(hi)<<32 | uint64(lo)
+}
+
+func (s *pcgSource) Int63() int64 {
+	return int64(s.Uint64() >> 1)
+}
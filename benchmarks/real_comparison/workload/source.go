@@ -0,0 +1,45 @@
+// Package workload provides pluggable, seeded PRNG sources so that
+// benchmark input generation is reproducible and comparable across
+// language ports of the same benchmark suite.
+package workload
+
+import (
+	"fmt"
+	mrand "math/rand"
+)
+
+// Names of the supported PRNG sources, as accepted by the -source flag.
+const (
+	PCG        = "pcg"
+	MT19937    = "mt19937"
+	Xoshiro256 = "xoshiro256"
+)
+
+// Sources lists the valid -source flag values, in the order they should be
+// presented in help text.
+var Sources = []string{PCG, MT19937, Xoshiro256}
+
+// NewSource returns a math/rand.Source64 of the named kind, seeded
+// deterministically from seed. The same (name, seed) pair always produces
+// the same stream of values.
+func NewSource(name string, seed uint64) (mrand.Source64, error) {
+	switch name {
+	case PCG:
+		return newPCGSource(seed), nil
+	case MT19937:
+		return newMT19937Source(seed), nil
+	case Xoshiro256:
+		return newXoshiro256Source(seed), nil
+	default:
+		return nil, fmt.Errorf("workload: unknown source %q (want one of %v)", name, Sources)
+	}
+}
+
+// NewRand returns a *math/rand.Rand backed by the named, seeded source.
+func NewRand(name string, seed uint64) (*mrand.Rand, error) {
+	src, err := NewSource(name, seed)
+	if err != nil {
+		return nil, err
+	}
+	return mrand.New(src), nil
+}
@@ -0,0 +1,52 @@
+package workload
+
+import "testing"
+
+func TestNewRandDeterministic(t *testing.T) {
+	for _, name := range Sources {
+		name := name
+		t.Run(name, func(t *testing.T) {
+			r1, err := NewRand(name, 42)
+			if err != nil {
+				t.Fatal(err)
+			}
+			r2, err := NewRand(name, 42)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			for i := 0; i < 100; i++ {
+				a, b := r1.Int63(), r2.Int63()
+				if a != b {
+					t.Fatalf("draw %d: same seed produced different values: %d != %d", i, a, b)
+				}
+			}
+		})
+	}
+}
+
+func TestNewRandDiffersBySeed(t *testing.T) {
+	for _, name := range Sources {
+		name := name
+		t.Run(name, func(t *testing.T) {
+			r1, err := NewRand(name, 1)
+			if err != nil {
+				t.Fatal(err)
+			}
+			r2, err := NewRand(name, 2)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if r1.Int63() == r2.Int63() {
+				t.Fatalf("different seeds produced the same first draw")
+			}
+		})
+	}
+}
+
+func TestNewRandUnknownSource(t *testing.T) {
+	if _, err := NewRand("bogus", 0); err == nil {
+		t.Fatal("expected an error for an unknown source")
+	}
+}
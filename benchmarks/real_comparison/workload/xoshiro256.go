@@ -0,0 +1,52 @@
+package workload
+
+// xoshiro256Source is a pure-Go implementation of xoshiro256**, seeded via
+// a SplitMix64 expansion of the single seed value.
+type xoshiro256Source struct {
+	state [4]uint64
+}
+
+func newXoshiro256Source(seed uint64) *xoshiro256Source {
+	s := &xoshiro256Source{}
+	s.Seed(int64(seed))
+	return s
+}
+
+func (s *xoshiro256Source) Seed(seed int64) {
+	sm := uint64(seed)
+	next := func() uint64 {
+		sm += 0x9E3779B97F4A7C15
+		z := sm
+		z = (z ^ (z >> 30)) * 0xBF58476D1CE4E5B9
+		z = (z ^ (z >> 27)) * 0x94D049BB133111EB
+		return z ^ (z >> 31)
+	}
+	for i := range s.state {
+		s.state[i] = next()
+	}
+}
+
+func rotl(x uint64, k uint) uint64 {
+	return (x << k) | (x >> (64 - k))
+}
+
+func (s *xoshiro256Source) Uint64() uint64 {
+	result := rotl(s.state[1]*5, 7) * 9
+
+	t := s.state[1] << 17
+
+	s.state[2] ^= s.state[0]
+	s.state[3] ^= s.state[1]
+	s.state[1] ^= s.state[2]
+	s.state[0] ^= s.state[3]
+
+	s.state[2] ^= t
+
+	s.state[3] = rotl(s.state[3], 45)
+
+	return result
+}
+
+func (s *xoshiro256Source) Int63() int64 {
+	return int64(s.Uint64() >> 1)
+}